@@ -0,0 +1,16 @@
+package testcontainers
+
+import (
+	"context"
+	"io"
+)
+
+// Adapted bridges a StartedContainer to the wait.StrategyTarget interface
+// expected by wait.Strategy implementations.
+type Adapted struct {
+	StartedContainer
+}
+
+func (a *Adapted) Logs(ctx context.Context) (io.ReadCloser, error) {
+	return a.StartedContainer.Logs(ctx)
+}
@@ -0,0 +1,156 @@
+package testcontainers
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// fsBuildContext packs a directory on the host filesystem into a build
+// context, honoring a .dockerignore file at its root.
+type fsBuildContext struct {
+	root string
+}
+
+// OnFileSystem builds the image from a Dockerfile and context that already
+// exist on disk at root.
+func OnFileSystem(root string) BuildContextProvider {
+	return fsBuildContext{root: root}
+}
+
+func (f fsBuildContext) buildContext() (io.Reader, error) {
+	ignore, err := newDockerignoreMatcher(f.root)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	err = filepath.Walk(f.root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(f.root, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if ignore.matches(rel) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = rel
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		contents, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer contents.Close()
+
+		_, err = io.Copy(tw, contents)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	return &buf, nil
+}
+
+// readerBuildContext wraps a build context that has already been tarred up
+// by the caller.
+type readerBuildContext struct {
+	r io.Reader
+}
+
+// FromContext builds the image from an already-tarred build context, e.g.
+// one downloaded or assembled ahead of time.
+func FromContext(r io.Reader) BuildContextProvider {
+	return readerBuildContext{r: r}
+}
+
+func (r readerBuildContext) buildContext() (io.Reader, error) {
+	return r.r, nil
+}
+
+// fsysBuildContext packs an fs.FS, such as an embed.FS, into a build
+// context.
+type fsysBuildContext struct {
+	fsys fs.FS
+}
+
+// FromFS builds the image from a Dockerfile and context embedded in the
+// binary via fs.FS, e.g. an embed.FS.
+func FromFS(fsys fs.FS) BuildContextProvider {
+	return fsysBuildContext{fsys: fsys}
+}
+
+func (f fsysBuildContext) buildContext() (io.Reader, error) {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	err := fs.WalkDir(f.fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == "." || d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = path
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		contents, err := f.fsys.Open(path)
+		if err != nil {
+			return err
+		}
+		defer contents.Close()
+
+		_, err = io.Copy(tw, contents)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	return &buf, nil
+}
@@ -0,0 +1,129 @@
+package testcontainers
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	container2 "github.com/docker/docker/api/types/container"
+	dockernetwork "github.com/docker/docker/api/types/network"
+	"github.com/docker/go-connections/nat"
+)
+
+// ContainerInfo is a snapshot of a container's low-level Docker state,
+// refreshed as needed to resolve values (such as a freshly published port)
+// that may not have been populated yet when the container was inspected.
+type ContainerInfo struct {
+	client *DockerClient
+	id     string
+
+	raw types.ContainerJSON
+}
+
+// Name returns the container's name, without Docker's leading slash.
+func (ci ContainerInfo) Name() string {
+	return strings.TrimPrefix(ci.raw.Name, "/")
+}
+
+// ContainerState returns the container's last known state (running,
+// exit code, health, ...).
+func (ci ContainerInfo) ContainerState() *container2.State {
+	return ci.raw.State
+}
+
+// Networks returns the endpoint settings for every network the container
+// is attached to, keyed by network name.
+func (ci ContainerInfo) Networks() map[string]*dockernetwork.EndpointSettings {
+	if ci.raw.NetworkSettings == nil {
+		return nil
+	}
+	return ci.raw.NetworkSettings.Networks
+}
+
+// Host returns the address other processes on this machine should use to
+// reach the container's published ports.
+func (ci ContainerInfo) Host() string {
+	if override := os.Getenv("TESTCONTAINERS_HOST_OVERRIDE"); override != "" {
+		return override
+	}
+
+	if isDockerInDocker() {
+		if gateway := ci.firstGateway(); gateway != "" {
+			return gateway
+		}
+	}
+
+	dockerHost := os.Getenv("DOCKER_HOST")
+	if dockerHost == "" {
+		return "localhost"
+	}
+
+	parsed, err := url.Parse(dockerHost)
+	if err != nil {
+		return "localhost"
+	}
+
+	switch parsed.Scheme {
+	case "unix", "npipe":
+		return "localhost"
+	default:
+		return parsed.Hostname()
+	}
+}
+
+func (ci ContainerInfo) firstGateway() string {
+	for _, endpoint := range ci.Networks() {
+		if endpoint.Gateway != "" {
+			return endpoint.Gateway
+		}
+	}
+	return ""
+}
+
+// MappedPort returns the host-side port Docker published containerPort to,
+// retrying briefly in case the mapping wasn't populated yet at the time
+// this ContainerInfo was fetched.
+func (ci ContainerInfo) MappedPort(containerPort nat.Port) string {
+	if port := ci.mappedPort(containerPort); port != "" {
+		return port
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		time.Sleep(100 * time.Millisecond)
+
+		raw, err := ci.client.ContainerInspect(context.Background(), ci.id)
+		if err != nil {
+			continue
+		}
+		ci.raw = raw
+
+		if port := ci.mappedPort(containerPort); port != "" {
+			return port
+		}
+	}
+
+	return ""
+}
+
+func (ci ContainerInfo) mappedPort(containerPort nat.Port) string {
+	if ci.raw.NetworkSettings == nil {
+		return ""
+	}
+	bindings := ci.raw.NetworkSettings.Ports[containerPort]
+	if len(bindings) == 0 {
+		return ""
+	}
+	return bindings[0].HostPort
+}
+
+// isDockerInDocker reports whether the current process is itself running
+// inside a container, e.g. one orchestrating other containers on a
+// mounted-in Docker socket.
+func isDockerInDocker() bool {
+	_, err := os.Stat("/.dockerenv")
+	return err == nil
+}
@@ -0,0 +1,44 @@
+package testcontainers
+
+import "testing"
+
+func TestContainerInfoHost(t *testing.T) {
+	tests := []struct {
+		name       string
+		override   string
+		dockerHost string
+		want       string
+	}{
+		{
+			name: "no override and no DOCKER_HOST defaults to localhost",
+			want: "localhost",
+		},
+		{
+			name:     "override wins over everything else",
+			override: "docker.example.com",
+			want:     "docker.example.com",
+		},
+		{
+			name:       "tcp DOCKER_HOST uses its hostname",
+			dockerHost: "tcp://192.0.2.10:2376",
+			want:       "192.0.2.10",
+		},
+		{
+			name:       "unix socket DOCKER_HOST falls back to localhost",
+			dockerHost: "unix:///var/run/docker.sock",
+			want:       "localhost",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TESTCONTAINERS_HOST_OVERRIDE", tt.override)
+			t.Setenv("DOCKER_HOST", tt.dockerHost)
+
+			ci := ContainerInfo{}
+			if got := ci.Host(); got != tt.want {
+				t.Errorf("Host() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package testcontainers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/testcontainers/testcontainers-go/network"
+)
+
+// ContainerSet is a group of container definitions, optionally wired
+// together with DependsOn, that are created and started together by Up.
+type ContainerSet struct {
+	definitions []*GenericContainerDefinition
+}
+
+// NewContainerSet groups definitions so they can be started together with
+// Up, honoring any DependsOn edges declared between them.
+func NewContainerSet(definitions ...*GenericContainerDefinition) *ContainerSet {
+	return &ContainerSet{definitions: definitions}
+}
+
+// StartedSet is the result of a successful Up: every member's
+// StartedContainer, plus a Terminate that tears the whole set down.
+type StartedSet struct {
+	containers map[*GenericContainerDefinition]*StartedContainer
+	order      []*GenericContainerDefinition
+
+	net    *network.Network
+	client *DockerClient
+}
+
+// Container returns the StartedContainer for a definition that was part of
+// the set passed to Up.
+func (s *StartedSet) Container(definition *GenericContainerDefinition) *StartedContainer {
+	return s.containers[definition]
+}
+
+// networkAttachmentContextKey carries the shared network a ContainerSet's
+// members should join so ContainerImageCreator.Create can wire it into
+// ContainerCreate's NetworkingConfig.
+type networkAttachmentContextKey struct{}
+
+type networkAttachment struct {
+	networkID string
+}
+
+func withNetworkAttachment(ctx context.Context, attachment networkAttachment) context.Context {
+	return context.WithValue(ctx, networkAttachmentContextKey{}, attachment)
+}
+
+func networkAttachmentFrom(ctx context.Context) (networkAttachment, bool) {
+	attachment, ok := ctx.Value(networkAttachmentContextKey{}).(networkAttachment)
+	return attachment, ok
+}
+
+// Terminate tears down every container started by Up, in reverse
+// topological order, then removes the shared network.
+func (s *StartedSet) Terminate(ctx context.Context) error {
+	var errs []error
+
+	for i := len(s.order) - 1; i >= 0; i-- {
+		definition := s.order[i]
+		started, ok := s.containers[definition]
+		if !ok || definition.terminator == nil {
+			continue
+		}
+		if err := definition.terminator.Terminate(ctx, started.CreatedContainer); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if s.net != nil {
+		if err := s.net.Terminate(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Up creates a shared network for set, then creates and starts every
+// definition in it: independent definitions start in parallel, and each
+// definition waits for its DependsOn targets to become ready before it is
+// created. If any member fails, everything already started is torn down in
+// reverse order.
+func Up(ctx context.Context, set *ContainerSet, option ...ExecutionOption) (*StartedSet, error) {
+	return tc.Up(ctx, set, option...)
+}
+
+func (t *Testcontainers) Up(ctx context.Context, set *ContainerSet, option ...ExecutionOption) (*StartedSet, error) {
+	conf := &ExecutionConfiguration{ctx: ctx}
+	for _, opt := range option {
+		opt(conf)
+	}
+	ctx = conf.ctx
+
+	order, err := topoSort(set.definitions)
+	if err != nil {
+		return nil, err
+	}
+
+	net, err := network.New(ctx, t.client, "testcontainers-"+uuid.NewString())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = withNetworkAttachment(ctx, networkAttachment{networkID: net.ID})
+
+	reaperEnabled := conf.reaperEnabled == nil || *conf.reaperEnabled
+	sessionID := t.ensureSessionID(conf.sessionID)
+
+	ctx = withReaperSettings(ctx, reaperSettings{sessionID: sessionID, reap: reaperEnabled})
+
+	if reaperEnabled {
+		if _, err := t.ensureReaper(ctx, sessionID); err != nil {
+			return nil, err
+		}
+	}
+
+	started := &StartedSet{
+		containers: make(map[*GenericContainerDefinition]*StartedContainer, len(order)),
+		order:      order,
+		net:        net,
+		client:     t.client,
+	}
+
+	ready := make(map[*GenericContainerDefinition]chan struct{}, len(order))
+	for _, definition := range order {
+		ready[definition] = make(chan struct{})
+	}
+
+	var mu sync.Mutex
+	g, gctx := errgroup.WithContext(ctx)
+
+	for _, definition := range order {
+		definition := definition
+		g.Go(func() error {
+			for _, dep := range definition.dependsOn {
+				select {
+				case <-ready[dep.on]:
+				case <-gctx.Done():
+					return gctx.Err()
+				}
+
+				mu.Lock()
+				dependency := started.containers[dep.on]
+				mu.Unlock()
+
+				if dep.wait != nil && dependency != nil {
+					if err := dep.wait.WaitUntilReady(gctx, &Adapted{*dependency}); err != nil {
+						return fmt.Errorf("waiting for dependency of %q: %w", definition.name, err)
+					}
+				}
+			}
+
+			created, err := definition.creator.Create(gctx, *definition)
+			if err != nil {
+				return err
+			}
+
+			startedContainer, err := definition.starter.Start(gctx, created)
+			if err != nil {
+				return err
+			}
+
+			mu.Lock()
+			started.containers[definition] = &startedContainer
+			mu.Unlock()
+
+			close(ready[definition])
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		_ = started.Terminate(context.Background())
+		return nil, err
+	}
+
+	return started, nil
+}
+
+// topoSort orders definitions so that every definition appears after the
+// ones it depends on, returning an error if the dependency graph has a
+// cycle.
+func topoSort(definitions []*GenericContainerDefinition) ([]*GenericContainerDefinition, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[*GenericContainerDefinition]int, len(definitions))
+	order := make([]*GenericContainerDefinition, 0, len(definitions))
+
+	var visit func(d *GenericContainerDefinition) error
+	visit = func(d *GenericContainerDefinition) error {
+		switch state[d] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("testcontainers: dependency cycle detected involving %q", d.name)
+		}
+
+		state[d] = visiting
+		for _, dep := range d.dependsOn {
+			if err := visit(dep.on); err != nil {
+				return err
+			}
+		}
+		state[d] = visited
+		order = append(order, d)
+		return nil
+	}
+
+	for _, d := range definitions {
+		if err := visit(d); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
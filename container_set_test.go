@@ -0,0 +1,45 @@
+package testcontainers
+
+import "testing"
+
+func TestTopoSortOrdersDependenciesFirst(t *testing.T) {
+	a := &GenericContainerDefinition{name: "a"}
+	b := &GenericContainerDefinition{name: "b", dependsOn: []containerDependency{{on: a}}}
+	c := &GenericContainerDefinition{name: "c", dependsOn: []containerDependency{{on: b}}}
+
+	order, err := topoSort([]*GenericContainerDefinition{c, b, a})
+	if err != nil {
+		t.Fatalf("topoSort returned error: %v", err)
+	}
+
+	index := make(map[*GenericContainerDefinition]int, len(order))
+	for i, d := range order {
+		index[d] = i
+	}
+
+	if index[a] >= index[b] {
+		t.Errorf("a must come before b: order = %v", names(order))
+	}
+	if index[b] >= index[c] {
+		t.Errorf("b must come before c: order = %v", names(order))
+	}
+}
+
+func TestTopoSortDetectsCycle(t *testing.T) {
+	a := &GenericContainerDefinition{name: "a"}
+	b := &GenericContainerDefinition{name: "b", dependsOn: []containerDependency{{on: a}}}
+	a.dependsOn = []containerDependency{{on: b}}
+
+	_, err := topoSort([]*GenericContainerDefinition{a, b})
+	if err == nil {
+		t.Fatal("expected a cycle detection error, got nil")
+	}
+}
+
+func names(definitions []*GenericContainerDefinition) []string {
+	names := make([]string, len(definitions))
+	for i, d := range definitions {
+		names[i] = d.name
+	}
+	return names
+}
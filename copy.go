@@ -0,0 +1,142 @@
+package testcontainers
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	container2 "github.com/docker/docker/api/types/container"
+)
+
+// CopyToContainer copies the file or directory at hostPath into the
+// container at containerPath.
+func (s *StartedContainer) CopyToContainer(ctx context.Context, hostPath, containerPath string) error {
+	content, err := tarPath(hostPath)
+	if err != nil {
+		return err
+	}
+	return s.client.CopyToContainer(ctx, s.ID, containerPath, content, container2.CopyToContainerOptions{})
+}
+
+// CopyFromContainer copies the file or directory at containerPath out of
+// the container into hostPath.
+func (s *StartedContainer) CopyFromContainer(ctx context.Context, containerPath, hostPath string) error {
+	reader, _, err := s.client.CopyFromContainer(ctx, s.ID, containerPath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	return untar(reader, hostPath)
+}
+
+// tarPath packs the file or directory at root into a tar stream, rooted at
+// its own base name so it lands at the destination path unpacked by the
+// daemon.
+func tarPath(root string) (io.Reader, error) {
+	if _, err := os.Stat(root); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+
+	base := filepath.Base(root)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		name := base
+		if rel != "." {
+			name = filepath.ToSlash(filepath.Join(base, rel))
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = name
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		contents, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer contents.Close()
+
+		_, err = io.Copy(tw, contents)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+
+	return &buf, nil
+}
+
+// untar extracts a tar stream into destDir, creating it if necessary.
+func untar(r io.Reader, destDir string) error {
+	if err := os.MkdirAll(destDir, 0o755); err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if !filepath.IsLocal(header.Name) {
+			return fmt.Errorf("testcontainers: tar entry %q escapes destination directory", header.Name)
+		}
+		if header.Typeflag == tar.TypeSymlink && !filepath.IsLocal(header.Linkname) {
+			return fmt.Errorf("testcontainers: tar entry %q links outside destination directory", header.Name)
+		}
+
+		target := filepath.Join(destDir, filepath.FromSlash(header.Name))
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, os.FileMode(header.Mode)); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(header.Mode))
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(file, tr); err != nil {
+				file.Close()
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package testcontainers
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/docker/docker/api/types"
+	container2 "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/system"
+	"github.com/docker/docker/client"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// DockerClient lazily wraps the Docker Engine API client so that
+// GenericContainerDefinition values can be built (and their image sources
+// constructed) before a daemon connection is actually required.
+type DockerClient struct {
+	mu  sync.Mutex
+	cli *client.Client
+}
+
+func (d *DockerClient) raw() (*client.Client, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.cli != nil {
+		return d.cli, nil
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+	d.cli = cli
+	return d.cli, nil
+}
+
+func (d *DockerClient) ContainerCreate(ctx context.Context, config *container2.Config, hostConfig *container2.HostConfig, networkingConfig *network.NetworkingConfig, platform *v1.Platform, containerName string) (container2.CreateResponse, error) {
+	cli, err := d.raw()
+	if err != nil {
+		return container2.CreateResponse{}, err
+	}
+	return cli.ContainerCreate(ctx, config, hostConfig, networkingConfig, platform, containerName)
+}
+
+func (d *DockerClient) ContainerStart(ctx context.Context, containerID string, options container2.StartOptions) error {
+	cli, err := d.raw()
+	if err != nil {
+		return err
+	}
+	return cli.ContainerStart(ctx, containerID, options)
+}
+
+// ImageBuild streams a build context to the daemon and returns the raw
+// build response, whose Body the caller is responsible for draining and
+// closing.
+func (d *DockerClient) ImageBuild(ctx context.Context, buildContext io.Reader, options types.ImageBuildOptions) (types.ImageBuildResponse, error) {
+	cli, err := d.raw()
+	if err != nil {
+		return types.ImageBuildResponse{}, err
+	}
+	return cli.ImageBuild(ctx, buildContext, options)
+}
+
+// ContainerLogs streams the combined stdout/stderr of a container, used by
+// wait strategies and the Follower helper.
+func (d *DockerClient) ContainerLogs(ctx context.Context, containerID string, options container2.LogsOptions) (io.ReadCloser, error) {
+	cli, err := d.raw()
+	if err != nil {
+		return nil, err
+	}
+	return cli.ContainerLogs(ctx, containerID, options)
+}
+
+// NetworkCreate creates a user-defined network, e.g. the shared bridge
+// network backing a ContainerSet.
+func (d *DockerClient) NetworkCreate(ctx context.Context, name string, options network.CreateOptions) (network.CreateResponse, error) {
+	cli, err := d.raw()
+	if err != nil {
+		return network.CreateResponse{}, err
+	}
+	return cli.NetworkCreate(ctx, name, options)
+}
+
+// NetworkRemove removes a previously created network.
+func (d *DockerClient) NetworkRemove(ctx context.Context, networkID string) error {
+	cli, err := d.raw()
+	if err != nil {
+		return err
+	}
+	return cli.NetworkRemove(ctx, networkID)
+}
+
+// ImagePull pulls an image, honoring the requested platform if one is set
+// in options.
+func (d *DockerClient) ImagePull(ctx context.Context, ref string, options image.PullOptions) (io.ReadCloser, error) {
+	cli, err := d.raw()
+	if err != nil {
+		return nil, err
+	}
+	return cli.ImagePull(ctx, ref, options)
+}
+
+// Info reports the Docker daemon's own OS and architecture, used for the
+// platform preflight check.
+func (d *DockerClient) Info(ctx context.Context) (system.Info, error) {
+	cli, err := d.raw()
+	if err != nil {
+		return system.Info{}, err
+	}
+	return cli.Info(ctx)
+}
+
+// ContainerExecCreate registers a new exec instance for a running
+// container.
+func (d *DockerClient) ContainerExecCreate(ctx context.Context, containerID string, options container2.ExecOptions) (types.IDResponse, error) {
+	cli, err := d.raw()
+	if err != nil {
+		return types.IDResponse{}, err
+	}
+	return cli.ContainerExecCreate(ctx, containerID, options)
+}
+
+// ContainerExecAttach attaches to a previously created exec instance and
+// starts it, returning its multiplexed stdout/stderr stream.
+func (d *DockerClient) ContainerExecAttach(ctx context.Context, execID string, options container2.ExecAttachOptions) (types.HijackedResponse, error) {
+	cli, err := d.raw()
+	if err != nil {
+		return types.HijackedResponse{}, err
+	}
+	return cli.ContainerExecAttach(ctx, execID, options)
+}
+
+// ContainerExecInspect reports the exit code of a finished exec instance.
+func (d *DockerClient) ContainerExecInspect(ctx context.Context, execID string) (container2.ExecInspect, error) {
+	cli, err := d.raw()
+	if err != nil {
+		return container2.ExecInspect{}, err
+	}
+	return cli.ContainerExecInspect(ctx, execID)
+}
+
+// CopyToContainer streams a tar archive into a container at dstPath.
+func (d *DockerClient) CopyToContainer(ctx context.Context, containerID, dstPath string, content io.Reader, options container2.CopyToContainerOptions) error {
+	cli, err := d.raw()
+	if err != nil {
+		return err
+	}
+	return cli.CopyToContainer(ctx, containerID, dstPath, content, options)
+}
+
+// CopyFromContainer streams a tar archive of srcPath out of a container.
+func (d *DockerClient) CopyFromContainer(ctx context.Context, containerID, srcPath string) (io.ReadCloser, container2.PathStat, error) {
+	cli, err := d.raw()
+	if err != nil {
+		return nil, container2.PathStat{}, err
+	}
+	return cli.CopyFromContainer(ctx, containerID, srcPath)
+}
+
+// ContainerInspect returns the full low-level information Docker holds
+// about a container.
+func (d *DockerClient) ContainerInspect(ctx context.Context, containerID string) (types.ContainerJSON, error) {
+	cli, err := d.raw()
+	if err != nil {
+		return types.ContainerJSON{}, err
+	}
+	return cli.ContainerInspect(ctx, containerID)
+}
+
+// ContainerRemove removes a container, used by DockerContainerTerminator.
+func (d *DockerClient) ContainerRemove(ctx context.Context, containerID string, options container2.RemoveOptions) error {
+	cli, err := d.raw()
+	if err != nil {
+		return err
+	}
+	return cli.ContainerRemove(ctx, containerID, options)
+}
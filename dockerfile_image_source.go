@@ -0,0 +1,123 @@
+package testcontainers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	"github.com/docker/docker/api/types"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// BuildContextProvider supplies the tar stream that is sent to the Docker
+// daemon as the build context for a FromDockerfileSource.
+type BuildContextProvider interface {
+	buildContext() (io.Reader, error)
+}
+
+// FromDockerfileSource is a ContainerImageSource that builds an image from a
+// Dockerfile and an associated build context, rather than pulling an
+// already-published image.
+type FromDockerfileSource struct {
+	dockerfilePath string
+	context        BuildContextProvider
+
+	buildArgs map[string]*string
+	target    string
+	platform  *v1.Platform
+}
+
+type FromDockerfileSourceOption func(*FromDockerfileSource)
+
+// WithBuildArgs sets the `--build-arg` values passed to the image build.
+func WithBuildArgs(args map[string]*string) FromDockerfileSourceOption {
+	return func(s *FromDockerfileSource) {
+		s.buildArgs = args
+	}
+}
+
+// WithTarget selects a build stage in a multi-stage Dockerfile.
+func WithTarget(target string) FromDockerfileSourceOption {
+	return func(s *FromDockerfileSource) {
+		s.target = target
+	}
+}
+
+// WithBuildPlatform pins the platform the image is built for, e.g.
+// "linux/amd64".
+func WithBuildPlatform(platform string) FromDockerfileSourceOption {
+	return func(s *FromDockerfileSource) {
+		s.platform = parsePlatform(platform)
+	}
+}
+
+// WithDockerfilePath overrides the path to the Dockerfile within the build
+// context, for cases where it differs from the path passed to
+// FromDockerfile.
+func WithDockerfilePath(path string) FromDockerfileSourceOption {
+	return func(s *FromDockerfileSource) {
+		s.dockerfilePath = path
+	}
+}
+
+// FromDockerfile builds an image from the Dockerfile at dockerfilePath
+// (resolved relative to the build context supplied by provider) instead of
+// pulling a pre-built image.
+func FromDockerfile(dockerfilePath string, provider BuildContextProvider, option ...FromDockerfileSourceOption) *FromDockerfileSource {
+	s := &FromDockerfileSource{
+		dockerfilePath: dockerfilePath,
+		context:        provider,
+	}
+
+	for _, opt := range option {
+		opt(s)
+	}
+	return s
+}
+
+func (f *FromDockerfileSource) Prepare(ctx context.Context, client *DockerClient) (string, error) {
+	buildContext, err := f.context.buildContext()
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, buildContext); err != nil {
+		return "", err
+	}
+
+	tag := "testcontainers-build:" + hashBuildContext(buf.Bytes())
+
+	opts := types.ImageBuildOptions{
+		Dockerfile: f.dockerfilePath,
+		Tags:       []string{tag},
+		BuildArgs:  f.buildArgs,
+		Target:     f.target,
+	}
+	if f.platform != nil {
+		opts.Platform = f.platform.OS + "/" + f.platform.Architecture
+	}
+
+	resp, err := client.ImageBuild(ctx, bytes.NewReader(buf.Bytes()), opts)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		return "", err
+	}
+
+	return tag, nil
+}
+
+func (f *FromDockerfileSource) requestedPlatform() *v1.Platform {
+	return f.platform
+}
+
+func hashBuildContext(tar []byte) string {
+	sum := sha256.Sum256(tar)
+	return hex.EncodeToString(sum[:])[:16]
+}
@@ -0,0 +1,111 @@
+package testcontainers
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// dockerignorePattern is one line of a .dockerignore file: a glob pattern,
+// optionally negated with a leading "!" to re-include a path an earlier
+// pattern excluded.
+type dockerignorePattern struct {
+	pattern string
+	negate  bool
+}
+
+// dockerignoreMatcher reports whether a path relative to a build context
+// root should be excluded, based on the patterns in a .dockerignore file.
+type dockerignoreMatcher struct {
+	patterns []dockerignorePattern
+}
+
+func newDockerignoreMatcher(root string) (*dockerignoreMatcher, error) {
+	f, err := os.Open(filepath.Join(root, ".dockerignore"))
+	if os.IsNotExist(err) {
+		return &dockerignoreMatcher{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var patterns []dockerignorePattern
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var negate bool
+		if strings.HasPrefix(line, "!") {
+			negate = true
+			line = line[1:]
+		}
+		line = strings.TrimSuffix(line, "/")
+		if line == "" {
+			continue
+		}
+
+		patterns = append(patterns, dockerignorePattern{pattern: line, negate: negate})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return &dockerignoreMatcher{patterns: patterns}, nil
+}
+
+// matches reports whether relPath is excluded, applying patterns in file
+// order so that a later negated pattern re-includes a path an earlier one
+// excluded, matching Docker's own .dockerignore precedence.
+func (m *dockerignoreMatcher) matches(relPath string) bool {
+	matched := false
+	for _, p := range m.patterns {
+		pattern := p.pattern
+		if strings.HasPrefix(pattern, "/") {
+			// A leading "/" anchors the pattern at the build context root.
+			pattern = strings.TrimPrefix(pattern, "/")
+		} else if !strings.Contains(pattern, "/") {
+			// An unanchored pattern matches at any depth, not just at the
+			// build context root.
+			pattern = "**/" + pattern
+		}
+		if globMatch(pattern, relPath) {
+			matched = !p.negate
+		}
+	}
+	return matched
+}
+
+// globMatch reports whether name matches pattern, where pattern is a
+// "/"-separated sequence of path.Match segments and "**" matches zero or
+// more whole segments.
+func globMatch(pattern, name string) bool {
+	return globMatchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func globMatchSegments(pattern, name []string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+	if pattern[0] == "**" {
+		if globMatchSegments(pattern[1:], name) {
+			return true
+		}
+		if len(name) == 0 {
+			return false
+		}
+		return globMatchSegments(pattern, name[1:])
+	}
+	if len(name) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(pattern[0], name[0]); !ok {
+		return false
+	}
+	return globMatchSegments(pattern[1:], name[1:])
+}
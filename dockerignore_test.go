@@ -0,0 +1,73 @@
+package testcontainers
+
+import "testing"
+
+func TestDockerignoreMatcherMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		relPath  string
+		want     bool
+	}{
+		{
+			name:     "unanchored pattern matches nested path",
+			patterns: []string{"node_modules"},
+			relPath:  "pkg/node_modules",
+			want:     true,
+		},
+		{
+			name:     "unanchored pattern matches top-level path",
+			patterns: []string{"node_modules"},
+			relPath:  "node_modules",
+			want:     true,
+		},
+		{
+			name:     "no match",
+			patterns: []string{"node_modules"},
+			relPath:  "pkg/main.go",
+			want:     false,
+		},
+		{
+			name:     "doublestar matches any depth",
+			patterns: []string{"**/*.log"},
+			relPath:  "a/b/c/debug.log",
+			want:     true,
+		},
+		{
+			name:     "anchored pattern does not match nested path",
+			patterns: []string{"/build"},
+			relPath:  "pkg/build",
+			want:     false,
+		},
+		{
+			name:     "negation re-includes a previously excluded path",
+			patterns: []string{"*.log", "!important.log"},
+			relPath:  "important.log",
+			want:     false,
+		},
+		{
+			name:     "later pattern wins over an earlier negation",
+			patterns: []string{"!important.log", "*.log"},
+			relPath:  "important.log",
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &dockerignoreMatcher{}
+			for _, pattern := range tt.patterns {
+				negate := false
+				if pattern[0] == '!' {
+					negate = true
+					pattern = pattern[1:]
+				}
+				m.patterns = append(m.patterns, dockerignorePattern{pattern: pattern, negate: negate})
+			}
+
+			if got := m.matches(tt.relPath); got != tt.want {
+				t.Errorf("matches(%q) = %v, want %v", tt.relPath, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package testcontainers
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	container2 "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// ExecConfiguration is built up by ExecOption values passed to
+// StartedContainer.Exec.
+type ExecConfiguration struct {
+	user       string
+	workingDir string
+	env        []string
+}
+
+type ExecOption func(*ExecConfiguration)
+
+// WithExecUser runs the command as user, e.g. "root" or "1000:1000".
+func WithExecUser(user string) ExecOption {
+	return func(c *ExecConfiguration) {
+		c.user = user
+	}
+}
+
+// WithExecWorkingDir sets the working directory the command runs in.
+func WithExecWorkingDir(dir string) ExecOption {
+	return func(c *ExecConfiguration) {
+		c.workingDir = dir
+	}
+}
+
+// WithExecEnv sets additional environment variables for the command, in
+// "KEY=VALUE" form.
+func WithExecEnv(env ...string) ExecOption {
+	return func(c *ExecConfiguration) {
+		c.env = append(c.env, env...)
+	}
+}
+
+// Exec runs cmd inside the container and blocks until it completes,
+// returning its exit code and the demultiplexed stdout/stderr it produced.
+func (s *StartedContainer) Exec(ctx context.Context, cmd []string, option ...ExecOption) (int, io.Reader, io.Reader, error) {
+	conf := &ExecConfiguration{}
+	for _, opt := range option {
+		opt(conf)
+	}
+
+	created, err := s.client.ContainerExecCreate(ctx, s.ID, container2.ExecOptions{
+		Cmd:          cmd,
+		AttachStdout: true,
+		AttachStderr: true,
+		User:         conf.user,
+		WorkingDir:   conf.workingDir,
+		Env:          conf.env,
+	})
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	attached, err := s.client.ContainerExecAttach(ctx, created.ID, container2.ExecAttachOptions{})
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer attached.Close()
+
+	var stdout, stderr bytes.Buffer
+	if _, err := stdcopy.StdCopy(&stdout, &stderr, attached.Reader); err != nil {
+		return 0, nil, nil, err
+	}
+
+	inspect, err := s.client.ContainerExecInspect(ctx, created.ID)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	return inspect.ExitCode, &stdout, &stderr, nil
+}
@@ -2,10 +2,17 @@ package testcontainers
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
+	"strings"
 
 	container2 "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	dockernetwork "github.com/docker/docker/api/types/network"
 	"github.com/docker/go-connections/nat"
+	"github.com/google/uuid"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 
 	"github.com/testcontainers/testcontainers-go/wait"
@@ -16,21 +23,45 @@ type GenericContainerDefinition struct {
 
 	exposedPorts []nat.Port
 
+	name      string
+	dependsOn []containerDependency
+
+	// platformCheck is nil when no preflight check against the Docker
+	// host's platform should be performed; otherwise its value selects
+	// whether a mismatch is fatal (true) or only logged (false).
+	platformCheck *bool
+
 	creator    ContainerCreator
 	starter    ContainerStarter
 	terminator ContainerTerminator
 }
 
+// containerDependency records that a definition must wait for another
+// definition, once started, to satisfy a readiness strategy before the
+// dependent is created. It backs the DependsOn option consumed by Up.
+type containerDependency struct {
+	on   *GenericContainerDefinition
+	wait wait.Strategy
+}
+
 type CreatedContainer struct {
 	ID string
 
 	definition GenericContainerDefinition
+	client     *DockerClient
 }
 
 type StartedContainer struct {
 	CreatedContainer
 }
 
+// Terminate removes the container using whichever ContainerTerminator the
+// definition it was created from configured. It is suitable for
+// registration with testing.T.Cleanup.
+func (s *StartedContainer) Terminate(ctx context.Context) error {
+	return s.definition.terminator.Terminate(ctx, s.CreatedContainer)
+}
+
 type GenericContainerOption func(*GenericContainerDefinition)
 
 func WithExposedPorts(ports ...nat.Port) GenericContainerOption {
@@ -50,8 +81,42 @@ func WaitingFor(wait wait.Strategy) GenericContainerOption {
 	}
 }
 
+// WithName gives the definition a stable name, used as its network alias
+// when started as part of a ContainerSet.
+func WithName(name string) GenericContainerOption {
+	return func(c *GenericContainerDefinition) {
+		c.name = name
+	}
+}
+
+// DependsOn declares that c must wait for other to satisfy wait before c is
+// created, when both are started together via Up. It has no effect outside
+// a ContainerSet.
+func DependsOn(other *GenericContainerDefinition, wait wait.Strategy) GenericContainerOption {
+	return func(c *GenericContainerDefinition) {
+		c.dependsOn = append(c.dependsOn, containerDependency{on: other, wait: wait})
+	}
+}
+
+// WithPlatformCheck enables a preflight check of the requested image
+// platform (see WithImagePlatform) against the Docker host's own OS/arch.
+// When strict is true a mismatch fails the run; otherwise it is only
+// logged through the injected slog.Logger.
+func WithPlatformCheck(strict bool) GenericContainerOption {
+	return func(c *GenericContainerDefinition) {
+		c.platformCheck = &strict
+	}
+}
+
 type ContainerImageSource interface {
-	Prepare(ctx context.Context) (string, error)
+	Prepare(ctx context.Context, client *DockerClient) (string, error)
+}
+
+// platformSource is implemented by ContainerImageSource values that pin a
+// specific image platform, so ContainerImageCreator can negotiate it with
+// the Docker host and pass it on to ContainerCreate.
+type platformSource interface {
+	requestedPlatform() *v1.Platform
 }
 
 type FromImageSource struct {
@@ -60,18 +125,34 @@ type FromImageSource struct {
 	platform *v1.Platform
 }
 
-func (f *FromImageSource) Prepare(ctx context.Context) (string, error) {
+func (f *FromImageSource) Prepare(ctx context.Context, client *DockerClient) (string, error) {
+	var pullPlatform string
+	if f.platform != nil {
+		pullPlatform = f.platform.OS + "/" + f.platform.Architecture
+	}
+
+	reader, err := client.ImagePull(ctx, f.image, image.PullOptions{Platform: pullPlatform})
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	if _, err := io.Copy(io.Discard, reader); err != nil {
+		return "", err
+	}
+
 	return f.image, nil
 }
 
+func (f *FromImageSource) requestedPlatform() *v1.Platform {
+	return f.platform
+}
+
 type FromImageSourceOption func(*FromImageSource)
 
 func WithImagePlatform(platform string) FromImageSourceOption {
 	return func(s *FromImageSource) {
-		s.platform = &v1.Platform{
-			Architecture: "amd64",
-			OS:           "linux",
-		}
+		s.platform = parsePlatform(platform)
 	}
 }
 
@@ -100,14 +181,51 @@ type ContainerTerminator interface {
 
 type ContainerImageCreator struct {
 	client *DockerClient
+	host   *hostInfoProbe
+	logger slog.Logger
 }
 
 func (c *ContainerImageCreator) Create(ctx context.Context, definition GenericContainerDefinition) (CreatedContainer, error) {
-	image, err := definition.imageSource.Prepare(ctx)
+	var platform *v1.Platform
+	if ps, ok := definition.imageSource.(platformSource); ok {
+		platform = ps.requestedPlatform()
+	}
+
+	if platform != nil && definition.platformCheck != nil {
+		if err := c.checkPlatform(ctx, platform, *definition.platformCheck); err != nil {
+			return CreatedContainer{}, err
+		}
+	}
+
+	image, err := definition.imageSource.Prepare(ctx, c.client)
 	if err != nil {
 		return CreatedContainer{}, err
 	}
-	created, err := c.client.ContainerCreate(ctx, nil, nil, nil, nil, "")
+
+	config := &container2.Config{
+		Image: image,
+	}
+	if settings, ok := reaperSettingsFrom(ctx); ok {
+		config.Labels = map[string]string{labelSessionID: settings.sessionID}
+		if settings.reap {
+			config.Labels[labelReap] = "true"
+		}
+	}
+
+	var networkingConfig *dockernetwork.NetworkingConfig
+	if attachment, ok := networkAttachmentFrom(ctx); ok {
+		endpoint := &dockernetwork.EndpointSettings{}
+		if definition.name != "" {
+			endpoint.Aliases = []string{definition.name}
+		}
+		networkingConfig = &dockernetwork.NetworkingConfig{
+			EndpointsConfig: map[string]*dockernetwork.EndpointSettings{
+				attachment.networkID: endpoint,
+			},
+		}
+	}
+
+	created, err := c.client.ContainerCreate(ctx, config, nil, networkingConfig, platform, definition.name)
 	if err != nil {
 		return CreatedContainer{}, err
 	}
@@ -115,9 +233,29 @@ func (c *ContainerImageCreator) Create(ctx context.Context, definition GenericCo
 		ID: created.ID,
 
 		definition: definition,
+		client:     c.client,
 	}, nil
 }
 
+func (c *ContainerImageCreator) checkPlatform(ctx context.Context, requested *v1.Platform, strict bool) error {
+	host, err := c.host.Info(ctx)
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(host.DockerHostOS, requested.OS) &&
+		(requested.Architecture == "" || strings.EqualFold(host.DockerHostArch, requested.Architecture)) {
+		return nil
+	}
+
+	msg := fmt.Sprintf("requested platform %s/%s does not match Docker host platform %s/%s", requested.OS, requested.Architecture, host.DockerHostOS, host.DockerHostArch)
+	if strict {
+		return errors.New(msg)
+	}
+	c.logger.Warn(msg)
+	return nil
+}
+
 type LoggingContainerCreator struct {
 	ContainerCreator
 
@@ -171,6 +309,11 @@ func NewGenericContainer(source ContainerImageSource, option ...GenericContainer
 
 type ExecutionConfiguration struct {
 	ctx context.Context
+
+	// reaperEnabled is nil when the caller didn't express a preference, in
+	// which case Run defaults it to enabled.
+	reaperEnabled *bool
+	sessionID     string
 }
 
 type ExecutionOption func(*ExecutionConfiguration)
@@ -181,23 +324,34 @@ func WithContext(ctx context.Context) ExecutionOption {
 	}
 }
 
-var tc = &Testcontainers{
-	client: &DockerClient{},
+// WithReaper enables or disables the Ryuk-style reaper that otherwise
+// guarantees container cleanup on process crash. It is enabled by default.
+func WithReaper(enabled bool) ExecutionOption {
+	return func(conf *ExecutionConfiguration) {
+		conf.reaperEnabled = &enabled
+	}
 }
 
-func Run(container *GenericContainerDefinition, option ...ExecutionOption) (*StartedContainer, error) {
-	return tc.Run(container, option...)
+// WithSessionID pins the session ID containers are labeled with, instead of
+// letting Testcontainers generate a random one. Containers sharing a
+// session ID are reaped together.
+func WithSessionID(sessionID string) ExecutionOption {
+	return func(conf *ExecutionConfiguration) {
+		conf.sessionID = sessionID
+	}
 }
 
-type ContainerInfo struct {
-}
+// defaultLogger discards all output, so that Testcontainers works out of the
+// box without panicking on the zero-value slog.Logger's nil handler.
+var defaultLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
 
-func (ci ContainerInfo) Host() string {
-	return ""
+var tc = &Testcontainers{
+	client: &DockerClient{},
+	logger: *defaultLogger,
 }
 
-func (ci ContainerInfo) MappedPort(port nat.Port) string {
-	return ""
+func Run(container *GenericContainerDefinition, option ...ExecutionOption) (*StartedContainer, error) {
+	return tc.Run(container, option...)
 }
 
 func Info(container *StartedContainer, option ...ExecutionOption) (ContainerInfo, error) {
@@ -208,15 +362,84 @@ type Testcontainers struct {
 	client *DockerClient
 
 	logger slog.Logger
+
+	probe *hostInfoProbe
+
+	sessionID string
+	reaper    *Reaper
+}
+
+// Close disconnects from the reaper, if one was started, causing it to
+// remove every container it is still watching.
+func (t *Testcontainers) Close() error {
+	if t.reaper == nil {
+		return nil
+	}
+	return t.reaper.Close()
+}
+
+// Close disconnects from the reaper started for the default Testcontainers
+// instance, if any.
+func Close() error {
+	return tc.Close()
+}
+
+// SetLogger overrides the slog.Logger used to report container lifecycle
+// events and non-fatal platform-check warnings, for containers built after
+// this call. It otherwise defaults to a logger that discards everything.
+func (t *Testcontainers) SetLogger(logger *slog.Logger) {
+	t.logger = *logger
+}
+
+// SetLogger overrides the logger used by the default Testcontainers
+// instance. See Testcontainers.SetLogger.
+func SetLogger(logger *slog.Logger) {
+	tc.SetLogger(logger)
+}
+
+func (t *Testcontainers) ensureSessionID(requested string) string {
+	if requested != "" {
+		return requested
+	}
+	if t.sessionID == "" {
+		t.sessionID = uuid.NewString()
+	}
+	return t.sessionID
+}
+
+func (t *Testcontainers) ensureReaper(ctx context.Context, sessionID string) (*Reaper, error) {
+	if t.reaper != nil {
+		return t.reaper, nil
+	}
+	reaper, err := newReaper(ctx, t.client, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	t.reaper = reaper
+	return reaper, nil
+}
+
+// HostInfo reports the Docker daemon's own OS and architecture.
+func (t *Testcontainers) HostInfo(ctx context.Context) (HostInfo, error) {
+	if t.probe == nil {
+		t.probe = &hostInfoProbe{client: t.client}
+	}
+	return t.probe.Info(ctx)
 }
 
 func (t *Testcontainers) NewGenericContainer(source ContainerImageSource, option ...GenericContainerOption) *GenericContainerDefinition {
+	if t.probe == nil {
+		t.probe = &hostInfoProbe{client: t.client}
+	}
+
 	c := &GenericContainerDefinition{
 		imageSource: source,
 
 		creator: &LoggingContainerCreator{
 			ContainerCreator: &ContainerImageCreator{
 				client: t.client,
+				host:   t.probe,
+				logger: t.logger,
 			},
 			logger: t.logger,
 		},
@@ -224,6 +447,10 @@ func (t *Testcontainers) NewGenericContainer(source ContainerImageSource, option
 		starter: &DockerContainerStarter{
 			client: t.client,
 		},
+
+		terminator: &DockerContainerTerminator{
+			client: t.client,
+		},
 	}
 
 	for _, opt := range option {
@@ -241,12 +468,23 @@ func (t *Testcontainers) Run(container *GenericContainerDefinition, option ...Ex
 		opt(conf)
 	}
 
-	created, err := container.creator.Create(conf.ctx, *container)
+	reaperEnabled := conf.reaperEnabled == nil || *conf.reaperEnabled
+	sessionID := t.ensureSessionID(conf.sessionID)
+
+	ctx := withReaperSettings(conf.ctx, reaperSettings{sessionID: sessionID, reap: reaperEnabled})
+
+	if reaperEnabled {
+		if _, err := t.ensureReaper(ctx, sessionID); err != nil {
+			return nil, err
+		}
+	}
+
+	created, err := container.creator.Create(ctx, *container)
 	if err != nil {
 		return nil, err
 	}
 
-	started, err := container.starter.Start(conf.ctx, created)
+	started, err := container.starter.Start(ctx, created)
 	if err != nil {
 		return nil, err
 	}
@@ -263,5 +501,10 @@ func (t *Testcontainers) Info(container *StartedContainer, option ...ExecutionOp
 		opt(conf)
 	}
 
-	return ContainerInfo{}, nil
+	inspect, err := t.client.ContainerInspect(conf.ctx, container.ID)
+	if err != nil {
+		return ContainerInfo{}, err
+	}
+
+	return ContainerInfo{client: t.client, id: container.ID, raw: inspect}, nil
 }
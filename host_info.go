@@ -0,0 +1,59 @@
+package testcontainers
+
+import (
+	"context"
+	"sync"
+)
+
+// HostInfo describes the Docker daemon's own operating system and CPU
+// architecture, as reported by the daemon rather than the local machine
+// running the client (they can differ, e.g. against a remote DOCKER_HOST).
+type HostInfo struct {
+	DockerHostOS   string
+	DockerHostArch string
+}
+
+// archAliases normalizes the uname-style architecture names Docker reports
+// into the GOARCH-style names used elsewhere (including in v1.Platform).
+var archAliases = map[string]string{
+	"x86_64":  "amd64",
+	"aarch64": "arm64",
+}
+
+func normalizeArch(arch string) string {
+	if normalized, ok := archAliases[arch]; ok {
+		return normalized
+	}
+	return arch
+}
+
+// hostInfoProbe caches a single HostInfo lookup for the lifetime of a
+// Testcontainers instance, since the Docker daemon's platform never
+// changes mid-session.
+type hostInfoProbe struct {
+	client *DockerClient
+
+	mu   sync.Mutex
+	info *HostInfo
+}
+
+func (p *hostInfoProbe) Info(ctx context.Context) (HostInfo, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.info != nil {
+		return *p.info, nil
+	}
+
+	sysInfo, err := p.client.Info(ctx)
+	if err != nil {
+		return HostInfo{}, err
+	}
+
+	info := HostInfo{
+		DockerHostOS:   sysInfo.OSType,
+		DockerHostArch: normalizeArch(sysInfo.Architecture),
+	}
+	p.info = &info
+	return info, nil
+}
@@ -0,0 +1,134 @@
+package testcontainers
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"log/slog"
+
+	container2 "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// LogConfiguration is built up by LogOption values passed to
+// StartedContainer.Logs.
+type LogConfiguration struct {
+	follow bool
+	since  string
+	tail   string
+}
+
+type LogOption func(*LogConfiguration)
+
+// WithFollow keeps the returned reader open and streams new log lines as
+// the container produces them.
+func WithFollow() LogOption {
+	return func(c *LogConfiguration) {
+		c.follow = true
+	}
+}
+
+// WithSince restricts logs to those produced since the given Docker
+// timestamp or duration, e.g. "2024-01-02T15:04:05" or "10m".
+func WithSince(since string) LogOption {
+	return func(c *LogConfiguration) {
+		c.since = since
+	}
+}
+
+// WithTail restricts logs to the last n lines, or "all" for the full
+// buffered history.
+func WithTail(tail string) LogOption {
+	return func(c *LogConfiguration) {
+		c.tail = tail
+	}
+}
+
+// Logs streams the container's combined stdout/stderr.
+func (s *StartedContainer) Logs(ctx context.Context, option ...LogOption) (io.ReadCloser, error) {
+	conf := &LogConfiguration{tail: "all"}
+	for _, opt := range option {
+		opt(conf)
+	}
+
+	raw, err := s.client.ContainerLogs(ctx, s.ID, container2.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     conf.follow,
+		Since:      conf.since,
+		Tail:       conf.tail,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return demuxLogs(raw), nil
+}
+
+// demuxLogs strips the 8-byte stdcopy frame header Docker multiplexes
+// stdout/stderr with on containers started without a TTY, returning a single
+// combined stream safe to scan line by line.
+func demuxLogs(raw io.ReadCloser) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		defer raw.Close()
+		_, err := stdcopy.StdCopy(pw, pw, raw)
+		pw.CloseWithError(err)
+	}()
+	return pr
+}
+
+// Follower forwards a container's logs, line by line, to an slog.Logger --
+// useful for debugging a wait strategy that never becomes ready.
+type Follower struct {
+	container *StartedContainer
+	logger    *slog.Logger
+	filter    func(line string) bool
+}
+
+type FollowerOption func(*Follower)
+
+// WithLineFilter restricts forwarded lines to those for which filter
+// returns true. The default forwards every line.
+func WithLineFilter(filter func(line string) bool) FollowerOption {
+	return func(f *Follower) {
+		f.filter = filter
+	}
+}
+
+// NewFollower builds a Follower for container that logs to logger.
+func NewFollower(container *StartedContainer, logger *slog.Logger, option ...FollowerOption) *Follower {
+	f := &Follower{
+		container: container,
+		logger:    logger,
+		filter:    func(string) bool { return true },
+	}
+	for _, opt := range option {
+		opt(f)
+	}
+	return f
+}
+
+// Start begins forwarding logs in a background goroutine. It returns once
+// the log stream is attached; forwarding stops when ctx is cancelled or the
+// container stops logging.
+func (f *Follower) Start(ctx context.Context) error {
+	reader, err := f.container.Logs(ctx, WithFollow())
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer reader.Close()
+
+		scanner := bufio.NewScanner(reader)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if f.filter(line) {
+				f.logger.Info(line)
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Package network manages user-defined Docker networks, such as the shared
+// bridge network a ContainerSet uses so its members can resolve each other
+// by name.
+package network
+
+import (
+	"context"
+
+	dockernetwork "github.com/docker/docker/api/types/network"
+)
+
+// Client is the subset of the Docker Engine API a Network needs. It is
+// satisfied structurally by *testcontainers.DockerClient.
+type Client interface {
+	NetworkCreate(ctx context.Context, name string, options dockernetwork.CreateOptions) (dockernetwork.CreateResponse, error)
+	NetworkRemove(ctx context.Context, networkID string) error
+}
+
+// Network is a user-defined bridge network created for the lifetime of a
+// ContainerSet.
+type Network struct {
+	ID   string
+	Name string
+
+	client Client
+}
+
+// New creates a user-defined bridge network so that containers attached to
+// it can resolve each other by name.
+func New(ctx context.Context, client Client, name string) (*Network, error) {
+	resp, err := client.NetworkCreate(ctx, name, dockernetwork.CreateOptions{
+		Driver: "bridge",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Network{ID: resp.ID, Name: name, client: client}, nil
+}
+
+// Terminate removes the network.
+func (n *Network) Terminate(ctx context.Context) error {
+	return n.client.NetworkRemove(ctx, n.ID)
+}
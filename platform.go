@@ -0,0 +1,17 @@
+package testcontainers
+
+import (
+	"strings"
+
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// parsePlatform turns a "os/arch" string, as accepted by the Docker CLI's
+// --platform flag, into an OCI platform descriptor.
+func parsePlatform(platform string) *v1.Platform {
+	os, arch, found := strings.Cut(platform, "/")
+	if !found {
+		return &v1.Platform{OS: os}
+	}
+	return &v1.Platform{OS: os, Architecture: arch}
+}
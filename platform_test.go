@@ -0,0 +1,35 @@
+package testcontainers
+
+import (
+	"testing"
+
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestParsePlatform(t *testing.T) {
+	tests := []struct {
+		name     string
+		platform string
+		want     *v1.Platform
+	}{
+		{
+			name:     "os and arch",
+			platform: "linux/arm64",
+			want:     &v1.Platform{OS: "linux", Architecture: "arm64"},
+		},
+		{
+			name:     "os only",
+			platform: "linux",
+			want:     &v1.Platform{OS: "linux"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parsePlatform(tt.platform)
+			if got.OS != tt.want.OS || got.Architecture != tt.want.Architecture {
+				t.Errorf("parsePlatform(%q) = %+v, want %+v", tt.platform, got, tt.want)
+			}
+		})
+	}
+}
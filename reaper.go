@@ -0,0 +1,119 @@
+package testcontainers
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	container2 "github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+)
+
+const (
+	labelSessionID = "org.testcontainers.session"
+	labelReap      = "org.testcontainers.reap"
+
+	reaperImage = "testcontainers/ryuk:0.8.1"
+	reaperPort  = "8080/tcp"
+)
+
+// Reaper is a Ryuk-style sidecar: it watches this process's connection over
+// a plain TCP socket and removes every container labeled with sessionID as
+// soon as the connection drops, guaranteeing cleanup even if the test
+// process panics or is killed.
+type Reaper struct {
+	sessionID string
+	client    *DockerClient
+	container CreatedContainer
+	conn      net.Conn
+}
+
+// newReaper starts the reaper sidecar and registers the filter that tells
+// it which containers belong to this session.
+func newReaper(ctx context.Context, client *DockerClient, sessionID string) (*Reaper, error) {
+	config := &container2.Config{
+		Image:        reaperImage,
+		ExposedPorts: nat.PortSet{reaperPort: {}},
+	}
+	hostConfig := &container2.HostConfig{
+		AutoRemove: true,
+		Binds:      []string{"/var/run/docker.sock:/var/run/docker.sock"},
+		PortBindings: nat.PortMap{
+			reaperPort: []nat.PortBinding{{}},
+		},
+	}
+
+	created, err := client.ContainerCreate(ctx, config, hostConfig, nil, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.ContainerStart(ctx, created.ID, container2.StartOptions{}); err != nil {
+		return nil, err
+	}
+
+	inspect, err := client.ContainerInspect(ctx, created.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := inspect.NetworkSettings.Ports[nat.Port(reaperPort)]
+	if len(bindings) == 0 {
+		return nil, fmt.Errorf("testcontainers: reaper container %s has no published port", created.ID)
+	}
+
+	conn, err := net.Dial("tcp", net.JoinHostPort("localhost", bindings[0].HostPort))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := fmt.Fprintf(conn, "label=%s=%s\n", labelSessionID, sessionID); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &Reaper{
+		sessionID: sessionID,
+		client:    client,
+		container: CreatedContainer{ID: created.ID, client: client},
+		conn:      conn,
+	}, nil
+}
+
+// Close disconnects from the reaper, which triggers it to remove every
+// container it is still watching and then exit.
+func (r *Reaper) Close() error {
+	if r.conn == nil {
+		return nil
+	}
+	return r.conn.Close()
+}
+
+type reaperContextKey struct{}
+
+type reaperSettings struct {
+	sessionID string
+	reap      bool
+}
+
+func withReaperSettings(ctx context.Context, settings reaperSettings) context.Context {
+	return context.WithValue(ctx, reaperContextKey{}, settings)
+}
+
+func reaperSettingsFrom(ctx context.Context) (reaperSettings, bool) {
+	settings, ok := ctx.Value(reaperContextKey{}).(reaperSettings)
+	return settings, ok
+}
+
+// DockerContainerTerminator removes a container outright, including its
+// volumes, implementing ContainerTerminator.
+type DockerContainerTerminator struct {
+	client *DockerClient
+}
+
+func (d *DockerContainerTerminator) Terminate(ctx context.Context, container CreatedContainer) error {
+	return d.client.ContainerRemove(ctx, container.ID, container2.RemoveOptions{
+		Force:         true,
+		RemoveVolumes: true,
+	})
+}
@@ -0,0 +1,83 @@
+// Package wait provides readiness strategies that block until a started
+// container is considered ready to use.
+package wait
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// StrategyTarget is the subset of a started container a Strategy needs in
+// order to decide whether it is ready.
+type StrategyTarget interface {
+	Logs(ctx context.Context) (io.ReadCloser, error)
+}
+
+// Strategy blocks WaitUntilReady until target is considered ready, or
+// returns an error if it never becomes so.
+type Strategy interface {
+	WaitUntilReady(ctx context.Context, target StrategyTarget) error
+}
+
+// LogStrategy waits until a given string appears in the container's logs.
+type LogStrategy struct {
+	log            string
+	startupTimeout time.Duration
+	pollInterval   time.Duration
+}
+
+// ForLog waits until log appears anywhere in the container's combined
+// stdout/stderr output.
+func ForLog(log string) *LogStrategy {
+	return &LogStrategy{
+		log:            log,
+		startupTimeout: 60 * time.Second,
+		pollInterval:   100 * time.Millisecond,
+	}
+}
+
+// WithStartupTimeout overrides the default 60s startup timeout.
+func (s *LogStrategy) WithStartupTimeout(timeout time.Duration) *LogStrategy {
+	s.startupTimeout = timeout
+	return s
+}
+
+func (s *LogStrategy) WaitUntilReady(ctx context.Context, target StrategyTarget) error {
+	ctx, cancel := context.WithTimeout(ctx, s.startupTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if ok, err := s.logsContainTarget(ctx, target); err != nil {
+			return err
+		} else if ok {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait.ForLog: timed out waiting for %q: %w", s.log, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *LogStrategy) logsContainTarget(ctx context.Context, target StrategyTarget) (bool, error) {
+	reader, err := target.Logs(ctx)
+	if err != nil {
+		return false, nil //nolint:nilerr // container may not be ready to stream logs yet
+	}
+	defer reader.Close()
+
+	logs, err := io.ReadAll(reader)
+	if err != nil {
+		return false, nil //nolint:nilerr // a torn read just means we retry on the next tick
+	}
+
+	return strings.Contains(string(logs), s.log), nil
+}